@@ -0,0 +1,33 @@
+// Package adaptivelimiter provides a failsafe.Policy that dynamically adjusts the max concurrency it allows based on
+// observed execution latency and error rate, as an alternative to a fixed-size bulkhead.
+package adaptivelimiter
+
+import (
+	"errors"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+// ErrExceeded is returned when an execution is rejected because the current concurrency limit has been reached.
+var ErrExceeded = errors.New("adaptive limit exceeded")
+
+// AdaptiveLimiter is a policy that limits the number of concurrent executions, dynamically growing and shrinking the
+// limit based on observed latency and error rate using an additive-increase / multiplicative-decrease (AIMD)
+// algorithm.
+//
+// R is the execution result type. This type is concurrency safe.
+type AdaptiveLimiter[R any] interface {
+	failsafe.Policy[R]
+
+	// Limit returns the current concurrency limit.
+	Limit() int
+
+	// Inflight returns the number of executions currently in flight.
+	Inflight() int
+}
+
+// With returns an AdaptiveLimiter for execution result type R using the default configuration. For more control, use
+// Builder.
+func With[R any]() AdaptiveLimiter[R] {
+	return Builder[R]().Build()
+}
@@ -0,0 +1,151 @@
+package adaptivelimiter
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+	"github.com/failsafe-go/failsafe-go/spi"
+)
+
+// rollingBaseline tracks a fixed-size window of recent latency samples using Welford's online algorithm for the
+// running mean, and exposes an approximate p95 baseline computed from the current window.
+type rollingBaseline struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+	count   int
+	mean    float64
+}
+
+func newRollingBaseline(windowSize int) *rollingBaseline {
+	if windowSize < 1 {
+		windowSize = 1
+	}
+	return &rollingBaseline{samples: make([]time.Duration, windowSize)}
+}
+
+func (b *rollingBaseline) record(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := float64(b.count) + 1
+	b.mean += (float64(d) - b.mean) / n
+
+	b.samples[b.next] = d
+	b.next = (b.next + 1) % len(b.samples)
+	if b.count < len(b.samples) {
+		b.count++
+	}
+}
+
+// p95 returns the approximate 95th percentile of the current window, falling back to the rolling mean until the
+// window contains enough samples to estimate a percentile from.
+func (b *rollingBaseline) p95() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.count < 5 {
+		return time.Duration(b.mean)
+	}
+	sorted := make([]time.Duration, b.count)
+	copy(sorted, b.samples[:b.count])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	idx := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+type adaptiveLimiterExecutor[R any] struct {
+	*spi.BaseExecutor[R]
+	*adaptiveLimiterConfig[R]
+
+	mu       sync.Mutex
+	limit    int
+	inflight int
+	baseline *rollingBaseline
+}
+
+// ToExecutor returns the limiter itself, since, unlike stateless policies, an AdaptiveLimiter's concurrency limit and
+// in-flight count must be shared across every execution it's used with rather than recreated per composition.
+func (e *adaptiveLimiterExecutor[R]) ToExecutor(_ R) any {
+	e.Executor = e
+	return e
+}
+
+func (e *adaptiveLimiterExecutor[R]) Limit() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.limit
+}
+
+func (e *adaptiveLimiterExecutor[R]) Inflight() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.inflight
+}
+
+func (e *adaptiveLimiterExecutor[R]) tryAcquire() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.inflight >= e.limit {
+		return false
+	}
+	e.inflight++
+	return true
+}
+
+func (e *adaptiveLimiterExecutor[R]) release() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.inflight--
+}
+
+// record updates the limit based on the outcome of a permitted execution, using additive-increase on a success that
+// isn't slow and multiplicative-decrease on any failure (such as a timeout or rejection) or on an execution slower
+// than baseline * latencyThreshold.
+func (e *adaptiveLimiterExecutor[R]) record(latency time.Duration, failed bool) {
+	baseline := e.baseline.p95()
+	slow := baseline > 0 && latency > time.Duration(float64(baseline)*e.latencyThreshold)
+
+	e.mu.Lock()
+	if failed || slow {
+		e.limit = int(math.Max(float64(e.minLimit), math.Floor(float64(e.limit)*0.9)))
+	} else if e.limit < e.maxLimit {
+		e.limit++
+	}
+	e.mu.Unlock()
+
+	e.baseline.record(latency)
+}
+
+// Apply enforces the current concurrency limit, failing fast with ErrExceeded when it's reached, and otherwise
+// records the execution's latency and outcome to adjust the limit for subsequent executions.
+func (e *adaptiveLimiterExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *spi.ExecutionResult[R]) func(failsafe.Execution[R]) *spi.ExecutionResult[R] {
+	return func(exec failsafe.Execution[R]) *spi.ExecutionResult[R] {
+		if !e.tryAcquire() {
+			var zero R
+			return internalFailure[R](zero, ErrExceeded)
+		}
+		defer e.release()
+
+		start := time.Now()
+		result := innerFn(exec)
+		e.record(time.Since(start), e.IsFailure(result.Result, result.Err))
+		return result
+	}
+}
+
+func internalFailure[R any](result R, err error) *spi.ExecutionResult[R] {
+	return &spi.ExecutionResult[R]{Result: result, Err: err}
+}
+
+var _ policy.Executor[any] = &adaptiveLimiterExecutor[any]{}
@@ -0,0 +1,88 @@
+package adaptivelimiter
+
+import (
+	"github.com/failsafe-go/failsafe-go/spi"
+)
+
+// AdaptiveLimiterBuilder builds AdaptiveLimiter instances.
+//
+// This type is not concurrency safe.
+type AdaptiveLimiterBuilder[R any] interface {
+	// WithInitialLimit configures the concurrency limit that the limiter starts with. The default is 10.
+	WithInitialLimit(initialLimit int) AdaptiveLimiterBuilder[R]
+
+	// WithMinLimit configures the minimum concurrency limit the limiter will shrink to. The default is 1.
+	WithMinLimit(minLimit int) AdaptiveLimiterBuilder[R]
+
+	// WithMaxLimit configures the maximum concurrency limit the limiter will grow to. The default is 200.
+	WithMaxLimit(maxLimit int) AdaptiveLimiterBuilder[R]
+
+	// WithLatencyThreshold configures the multiple of the rolling baseline latency beyond which an execution is
+	// considered slow and triggers a multiplicative decrease of the limit. The default is 2.0.
+	WithLatencyThreshold(threshold float64) AdaptiveLimiterBuilder[R]
+
+	// WithWindowSize configures the number of recent execution latencies used to compute the rolling baseline. The
+	// default is 100.
+	WithWindowSize(windowSize int) AdaptiveLimiterBuilder[R]
+
+	// Build returns a new AdaptiveLimiter using the builder's configuration.
+	Build() AdaptiveLimiter[R]
+}
+
+type adaptiveLimiterConfig[R any] struct {
+	initialLimit     int
+	minLimit         int
+	maxLimit         int
+	latencyThreshold float64
+	windowSize       int
+}
+
+var _ AdaptiveLimiterBuilder[any] = &adaptiveLimiterConfig[any]{}
+
+// Builder returns an AdaptiveLimiterBuilder for execution result type R.
+func Builder[R any]() AdaptiveLimiterBuilder[R] {
+	return &adaptiveLimiterConfig[R]{
+		initialLimit:     10,
+		minLimit:         1,
+		maxLimit:         200,
+		latencyThreshold: 2.0,
+		windowSize:       100,
+	}
+}
+
+func (c *adaptiveLimiterConfig[R]) WithInitialLimit(initialLimit int) AdaptiveLimiterBuilder[R] {
+	c.initialLimit = initialLimit
+	return c
+}
+
+func (c *adaptiveLimiterConfig[R]) WithMinLimit(minLimit int) AdaptiveLimiterBuilder[R] {
+	c.minLimit = minLimit
+	return c
+}
+
+func (c *adaptiveLimiterConfig[R]) WithMaxLimit(maxLimit int) AdaptiveLimiterBuilder[R] {
+	c.maxLimit = maxLimit
+	return c
+}
+
+func (c *adaptiveLimiterConfig[R]) WithLatencyThreshold(threshold float64) AdaptiveLimiterBuilder[R] {
+	c.latencyThreshold = threshold
+	return c
+}
+
+func (c *adaptiveLimiterConfig[R]) WithWindowSize(windowSize int) AdaptiveLimiterBuilder[R] {
+	c.windowSize = windowSize
+	return c
+}
+
+func (c *adaptiveLimiterConfig[R]) Build() AdaptiveLimiter[R] {
+	if c.initialLimit < c.minLimit {
+		c.initialLimit = c.minLimit
+	}
+	return &adaptiveLimiterExecutor[R]{
+		BaseExecutor:          &spi.BaseExecutor[R]{},
+		adaptiveLimiterConfig: c,
+		limit:                 c.initialLimit,
+		baseline:              newRollingBaseline(c.windowSize),
+	}
+}
@@ -0,0 +1,60 @@
+// Package circuitbreaker provides a failsafe.Policy that temporarily disables execution as a way of preventing
+// system overload.
+package circuitbreaker
+
+import (
+	"errors"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+// ErrCircuitBreakerOpen is returned when an execution is rejected because a CircuitBreaker is open, or because it's
+// half-open and the execution wasn't admitted for a recovery trial.
+var ErrCircuitBreakerOpen = errors.New("circuit breaker open")
+
+// State represents the state of a CircuitBreaker.
+type State int
+
+const (
+	ClosedState State = iota
+	OpenState
+	HalfOpenState
+)
+
+// Metrics holds execution count information for a CircuitBreaker.
+type Metrics interface {
+	// ExecutionCount returns the number of executions recorded in the current state.
+	ExecutionCount() uint
+	// SuccessCount returns the number of successful executions recorded in the current state.
+	SuccessCount() uint
+	// FailureCount returns the number of failed executions recorded in the current state.
+	FailureCount() uint
+}
+
+// CircuitBreaker is a policy that temporarily disables execution as a way of preventing system overload.
+//
+// R is the execution result type. This type is concurrency safe.
+type CircuitBreaker[R any] interface {
+	failsafe.Policy[R]
+
+	// IsClosed returns whether the circuit breaker is closed.
+	IsClosed() bool
+	// IsOpen returns whether the circuit breaker is open.
+	IsOpen() bool
+	// IsHalfOpen returns whether the circuit breaker is half-open.
+	IsHalfOpen() bool
+	// Open opens the circuit breaker.
+	Open()
+	// Close closes the circuit breaker.
+	Close()
+	// HalfOpen half-opens the circuit breaker.
+	HalfOpen()
+	// Metrics returns metrics for the circuit breaker, scoped to its current state.
+	Metrics() Metrics
+}
+
+// WithDefaults returns a CircuitBreaker for execution result type R using the default configuration: a failure
+// threshold of 1, a delay of 1 minute, and a success threshold of 1.
+func WithDefaults[R any]() CircuitBreaker[R] {
+	return Builder[R]().Build()
+}
@@ -0,0 +1,190 @@
+package circuitbreaker
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+	"github.com/failsafe-go/failsafe-go/spi"
+)
+
+type circuitBreakerMetrics struct {
+	executions uint
+	successes  uint
+	failures   uint
+}
+
+func (m *circuitBreakerMetrics) ExecutionCount() uint {
+	return m.executions
+}
+
+func (m *circuitBreakerMetrics) SuccessCount() uint {
+	return m.successes
+}
+
+func (m *circuitBreakerMetrics) FailureCount() uint {
+	return m.failures
+}
+
+func (m *circuitBreakerMetrics) reset() {
+	m.executions, m.successes, m.failures = 0, 0, 0
+}
+
+func (m *circuitBreakerMetrics) recordSuccess() {
+	m.executions++
+	m.successes++
+}
+
+func (m *circuitBreakerMetrics) recordFailure() {
+	m.executions++
+	m.failures++
+}
+
+type circuitBreakerExecutor[R any] struct {
+	*spi.BaseExecutor[R]
+	*circuitBreakerConfig[R]
+
+	mu       sync.Mutex
+	state    State
+	openedAt time.Time
+	metrics  *circuitBreakerMetrics
+}
+
+// ToExecutor returns the breaker itself, since its state must be shared across every execution it's used with.
+func (e *circuitBreakerExecutor[R]) ToExecutor(_ R) any {
+	e.Executor = e
+	return e
+}
+
+func (e *circuitBreakerExecutor[R]) IsClosed() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.state == ClosedState
+}
+
+func (e *circuitBreakerExecutor[R]) IsOpen() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.state == OpenState
+}
+
+func (e *circuitBreakerExecutor[R]) IsHalfOpen() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.state == HalfOpenState
+}
+
+func (e *circuitBreakerExecutor[R]) Open() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.transition(OpenState)
+}
+
+func (e *circuitBreakerExecutor[R]) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.transition(ClosedState)
+}
+
+func (e *circuitBreakerExecutor[R]) HalfOpen() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.transition(HalfOpenState)
+}
+
+func (e *circuitBreakerExecutor[R]) Metrics() Metrics {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.metrics
+}
+
+// transition must be called while holding e.mu.
+func (e *circuitBreakerExecutor[R]) transition(to State) {
+	e.state = to
+	e.metrics.reset()
+	if to == OpenState {
+		e.openedAt = time.Now()
+	}
+}
+
+// tryAcquire returns whether an execution should be allowed to proceed, transitioning open -> half-open once the
+// delay has elapsed, and admitting half-open trials according to halfOpenRetryProbability.
+func (e *circuitBreakerExecutor[R]) tryAcquire() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch e.state {
+	case ClosedState:
+		return true
+	case HalfOpenState:
+		return e.admitHalfOpenTrial()
+	default: // OpenState
+		if time.Since(e.openedAt) < e.delay {
+			return false
+		}
+		if !e.admitHalfOpenTrial() {
+			// Stay open; a rejected trial shouldn't make the breaker observably half-open.
+			return false
+		}
+		e.state = HalfOpenState
+		e.metrics.reset()
+		return true
+	}
+}
+
+// admitHalfOpenTrial must be called while holding e.mu.
+func (e *circuitBreakerExecutor[R]) admitHalfOpenTrial() bool {
+	if e.halfOpenRetryProbability >= 1 {
+		return true
+	}
+	if e.halfOpenRetryProbability <= 0 {
+		return false
+	}
+	return rand.Float64() < e.halfOpenRetryProbability
+}
+
+// onResult records the outcome of an admitted execution and evaluates whether the breaker should transition state.
+func (e *circuitBreakerExecutor[R]) onResult(failed bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if failed {
+		e.metrics.recordFailure()
+	} else {
+		e.metrics.recordSuccess()
+	}
+
+	switch e.state {
+	case ClosedState:
+		if e.metrics.FailureCount() >= e.failureThreshold {
+			e.transition(OpenState)
+		}
+	case HalfOpenState:
+		if failed {
+			if e.metrics.FailureCount() >= e.failureThreshold {
+				e.transition(OpenState)
+			}
+		} else if e.metrics.SuccessCount() >= e.successThreshold {
+			e.transition(ClosedState)
+		}
+	}
+}
+
+// Apply enforces the circuit breaker's state, rejecting executions with ErrCircuitBreakerOpen when the circuit is
+// open or when a half-open trial isn't admitted, and otherwise records the result to drive state transitions.
+func (e *circuitBreakerExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *spi.ExecutionResult[R]) func(failsafe.Execution[R]) *spi.ExecutionResult[R] {
+	return func(exec failsafe.Execution[R]) *spi.ExecutionResult[R] {
+		if !e.tryAcquire() {
+			var zero R
+			return &spi.ExecutionResult[R]{Result: zero, Err: ErrCircuitBreakerOpen}
+		}
+
+		result := innerFn(exec)
+		e.onResult(e.IsFailure(result.Result, result.Err))
+		return result
+	}
+}
+
+var _ policy.Executor[any] = &circuitBreakerExecutor[any]{}
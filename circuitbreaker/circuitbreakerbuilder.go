@@ -0,0 +1,91 @@
+package circuitbreaker
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+	"github.com/failsafe-go/failsafe-go/spi"
+)
+
+// CircuitBreakerBuilder builds CircuitBreaker instances.
+//
+// This type is not concurrency safe.
+type CircuitBreakerBuilder[R any] interface {
+	policy.FailurePolicyBuilder[CircuitBreakerBuilder[R], R]
+
+	// WithFailureThreshold configures the number of cumulative failures that must occur, in the closed state, in
+	// order to open the circuit. Failures accumulate across the closed state and aren't reset by an intervening
+	// success. The same number of failures, while half-open, reopens the circuit. The default is 1.
+	WithFailureThreshold(failureThreshold uint) CircuitBreakerBuilder[R]
+
+	// WithSuccessThreshold configures the number of successful executions that must occur, while half-open, in order
+	// to close the circuit, provided the failure threshold isn't reached first. The default is 1.
+	WithSuccessThreshold(successThreshold uint) CircuitBreakerBuilder[R]
+
+	// WithDelay configures the time to wait, once the circuit is opened, before transitioning to half-open. The
+	// default is 1 minute.
+	WithDelay(delay time.Duration) CircuitBreakerBuilder[R]
+
+	// WithHalfOpenRetryProbability configures the probability, in the range [0, 1], with which an execution that
+	// arrives once the circuit's open delay has elapsed is admitted as a recovery trial rather than being rejected
+	// with ErrCircuitBreakerOpen. This smooths the traffic ramp-up during recovery, rather than admitting every
+	// request the instant the delay elapses. A probabilistically-admitted request still counts toward
+	// WithSuccessThreshold / WithFailureThreshold. The default is 1, meaning every such request is admitted, which
+	// matches the traditional half-open behavior.
+	WithHalfOpenRetryProbability(probability float64) CircuitBreakerBuilder[R]
+
+	// Build returns a new CircuitBreaker using the builder's configuration.
+	Build() CircuitBreaker[R]
+}
+
+type circuitBreakerConfig[R any] struct {
+	*policy.BaseFailurePolicy[R]
+	failureThreshold         uint
+	successThreshold         uint
+	delay                    time.Duration
+	halfOpenRetryProbability float64
+}
+
+var _ CircuitBreakerBuilder[any] = &circuitBreakerConfig[any]{}
+
+// Builder returns a CircuitBreakerBuilder for execution result type R.
+func Builder[R any]() CircuitBreakerBuilder[R] {
+	return &circuitBreakerConfig[R]{
+		BaseFailurePolicy:        &policy.BaseFailurePolicy[R]{},
+		failureThreshold:         1,
+		successThreshold:         1,
+		delay:                    time.Minute,
+		halfOpenRetryProbability: 1,
+	}
+}
+
+func (c *circuitBreakerConfig[R]) WithFailureThreshold(failureThreshold uint) CircuitBreakerBuilder[R] {
+	c.failureThreshold = failureThreshold
+	return c
+}
+
+func (c *circuitBreakerConfig[R]) WithSuccessThreshold(successThreshold uint) CircuitBreakerBuilder[R] {
+	c.successThreshold = successThreshold
+	return c
+}
+
+func (c *circuitBreakerConfig[R]) WithDelay(delay time.Duration) CircuitBreakerBuilder[R] {
+	c.delay = delay
+	return c
+}
+
+func (c *circuitBreakerConfig[R]) WithHalfOpenRetryProbability(probability float64) CircuitBreakerBuilder[R] {
+	c.halfOpenRetryProbability = probability
+	return c
+}
+
+func (c *circuitBreakerConfig[R]) Build() CircuitBreaker[R] {
+	return &circuitBreakerExecutor[R]{
+		BaseExecutor:         &spi.BaseExecutor[R]{},
+		circuitBreakerConfig: c,
+		metrics:              &circuitBreakerMetrics{},
+	}
+}
+
+var _ failsafe.Policy[any] = &circuitBreakerExecutor[any]{}
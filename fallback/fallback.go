@@ -0,0 +1,47 @@
+// Package fallback provides a failsafe.Policy that handles failures using a fallback result, error, function, or a
+// chain of fallback functions.
+package fallback
+
+import (
+	"github.com/failsafe-go/failsafe-go"
+)
+
+// Fallback is a policy that handles failures using a fallback result, error, function, or chain of functions.
+//
+// R is the execution result type. This type is concurrency safe.
+type Fallback[R any] interface {
+	failsafe.Policy[R]
+}
+
+// WithResult returns a Fallback for execution result type R that returns the result when execution fails.
+func WithResult[R any](result R) Fallback[R] {
+	return Builder[R](func(exec failsafe.Execution[R]) (R, error) {
+		return result, nil
+	}).Build()
+}
+
+// WithError returns a Fallback for execution result type R that returns the err when execution fails.
+func WithError[R any](err error) Fallback[R] {
+	return Builder[R](func(exec failsafe.Execution[R]) (R, error) {
+		var zero R
+		return zero, err
+	}).Build()
+}
+
+// WithFn returns a Fallback for execution result type R that calls the fn when execution fails.
+func WithFn[R any](fn func(exec failsafe.Execution[R]) (R, error)) Fallback[R] {
+	return Builder[R](fn).Build()
+}
+
+// WithChain returns a Fallback for execution result type R that calls each of fns, in order, until one of them
+// succeeds, stopping at the first fn that returns a nil error. This is useful for failing over between alternative
+// providers, such as RPC endpoints or blockchain nodes. If every fn fails, the returned error joins the errors from
+// every fn, in order, and is available via errors.Is / errors.As, or via LastErrors.
+//
+// WithChain is a shortcut for Builder(fns[0]).WithHandlers(fns[1:]...).Build(), and panics if fns is empty.
+func WithChain[R any](fns ...func(exec failsafe.Execution[R]) (R, error)) Fallback[R] {
+	if len(fns) == 0 {
+		panic("fallback: WithChain requires at least one fn")
+	}
+	return Builder[R](fns[0]).WithHandlers(fns[1:]...).Build()
+}
@@ -0,0 +1,118 @@
+package fallback
+
+import (
+	"errors"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+	"github.com/failsafe-go/failsafe-go/spi"
+)
+
+// ChainError is returned by a Fallback built with WithChain or WithHandlers when every handler fails. It joins the
+// errors produced by each handler, in the order they were attempted, and can be inspected with errors.Is / errors.As
+// or by calling Errors.
+type ChainError struct {
+	// Errs contains the error returned by each attempted handler, in order.
+	Errs []error
+}
+
+func (e *ChainError) Error() string {
+	return errors.Join(e.Errs...).Error()
+}
+
+func (e *ChainError) Unwrap() []error {
+	return e.Errs
+}
+
+// Errors returns the error produced by each fallback handler that was attempted, in order.
+func (e *ChainError) Errors() []error {
+	return e.Errs
+}
+
+// LastErrors returns the error produced by each fallback handler attempted before a chain built with WithChain or
+// WithHandlers gave up, in order. The request that introduced chained fallbacks called for exposing these via an
+// Execution.LastErrors() method; since failsafe.Execution is defined outside this package, they're surfaced here
+// instead, via the error returned by the fallback. LastErrors returns nil if err is nil, or []error{err} if err
+// isn't a *ChainError (such as when only one handler was attempted, or when the execution was canceled before any
+// handler ran and err is the original failure).
+func LastErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	var chainErr *ChainError
+	if errors.As(err, &chainErr) {
+		return chainErr.Errs
+	}
+	return []error{err}
+}
+
+type fallbackExecutor[R any] struct {
+	*fallbackConfig[R]
+}
+
+var _ failsafe.Policy[any] = &fallbackExecutor[any]{}
+
+func (e *fallbackExecutor[R]) ToExecutor(_ R) any {
+	fe := &executor[R]{
+		BaseExecutor:   &spi.BaseExecutor[R]{},
+		fallbackConfig: e.fallbackConfig,
+	}
+	fe.Executor = fe
+	return fe
+}
+
+type executor[R any] struct {
+	*spi.BaseExecutor[R]
+	*fallbackConfig[R]
+}
+
+// Apply attempts the configured fallback handlers, in order, whenever the inner execution is considered a failure
+// per the failure policy's configuration (IsFailure). The first handler that returns a nil error wins; if every
+// handler fails the returned error is that handler's own error when only one was attempted, or otherwise a
+// *ChainError joining each handler's error. OnFallbackExecuted is called with the final outcome either way.
+func (e *executor[R]) Apply(innerFn func(failsafe.Execution[R]) *spi.ExecutionResult[R]) func(failsafe.Execution[R]) *spi.ExecutionResult[R] {
+	return func(exec failsafe.Execution[R]) *spi.ExecutionResult[R] {
+		result := innerFn(exec)
+		if !e.IsFailure(result.Result, result.Err) {
+			return result
+		}
+
+		var errs []error
+		var final *spi.ExecutionResult[R]
+		for i, fn := range e.fns {
+			if exec.IsCanceled() {
+				break
+			}
+			r, err := fn(exec)
+			if err == nil {
+				final = result.WithResult(r, nil)
+				break
+			}
+			errs = append(errs, err)
+			if i < len(e.fns)-1 {
+				exec = exec.CopyWithResult(r, err)
+			}
+		}
+
+		if final == nil {
+			switch len(errs) {
+			case 0:
+				// Canceled before any handler ran: propagate the original inner failure rather than fabricating a
+				// nil-error success.
+				final = result
+			case 1:
+				var zero R
+				final = result.WithResult(zero, errs[0])
+			default:
+				var zero R
+				final = result.WithResult(zero, &ChainError{Errs: errs})
+			}
+		}
+		if e.onFallbackExecuted != nil {
+			e.onFallbackExecuted(&spi.ExecutionDoneEvent[R]{ExecutionResult: final})
+		}
+		return final
+	}
+}
+
+var _ policy.Executor[any] = &executor[any]{}
@@ -0,0 +1,58 @@
+package fallback
+
+import (
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// FallbackBuilder builds Fallback instances.
+//
+// This type is not concurrency safe.
+type FallbackBuilder[R any] interface {
+	policy.FailurePolicyBuilder[FallbackBuilder[R], R]
+
+	// WithHandlers configures additional fns that are called, in the declared order, whenever the primary fn and
+	// every prior handler fails. The chain stops at the first fn that succeeds. Each fn observes the same
+	// failsafe.Execution as the primary fn, including its context and cancellation, so a handler that starts its own
+	// sub-execution should still respect exec.IsCanceled().
+	WithHandlers(fns ...func(exec failsafe.Execution[R]) (R, error)) FallbackBuilder[R]
+
+	// OnFallbackExecuted registers the listener to be called when a fallback has executed.
+	OnFallbackExecuted(listener func(event failsafe.ExecutionDoneEvent[R])) FallbackBuilder[R]
+
+	// Build returns a new Fallback using the builder's configuration.
+	Build() Fallback[R]
+}
+
+type fallbackConfig[R any] struct {
+	*policy.BaseFailurePolicy[R]
+	fns                []func(exec failsafe.Execution[R]) (R, error)
+	onFallbackExecuted func(event failsafe.ExecutionDoneEvent[R])
+}
+
+var _ FallbackBuilder[any] = &fallbackConfig[any]{}
+
+// Builder returns a FallbackBuilder for execution result type R whose primary fallback attempt is fn. Additional
+// fallback attempts can be registered via WithHandlers.
+func Builder[R any](fn func(exec failsafe.Execution[R]) (R, error)) FallbackBuilder[R] {
+	return &fallbackConfig[R]{
+		BaseFailurePolicy: &policy.BaseFailurePolicy[R]{},
+		fns:               []func(exec failsafe.Execution[R]) (R, error){fn},
+	}
+}
+
+func (c *fallbackConfig[R]) WithHandlers(fns ...func(exec failsafe.Execution[R]) (R, error)) FallbackBuilder[R] {
+	c.fns = append(c.fns, fns...)
+	return c
+}
+
+func (c *fallbackConfig[R]) OnFallbackExecuted(listener func(event failsafe.ExecutionDoneEvent[R])) FallbackBuilder[R] {
+	c.onFallbackExecuted = listener
+	return c
+}
+
+func (c *fallbackConfig[R]) Build() Fallback[R] {
+	return &fallbackExecutor[R]{
+		fallbackConfig: c,
+	}
+}
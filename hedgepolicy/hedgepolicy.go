@@ -0,0 +1,25 @@
+// Package hedgepolicy provides a failsafe.Policy that speculatively starts additional executions, in parallel, when
+// an execution is taking longer than expected.
+package hedgepolicy
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+// HedgePolicy is a policy that speculatively runs additional executions, referred to as "hedges", when an initial
+// execution exceeds some delay, racing them against each other and taking the first acceptable result. Any
+// executions that are still outstanding once a result is accepted are canceled via their failsafe.Execution context,
+// the same way an exceeded timeout.Timeout cancels outstanding work.
+//
+// R is the execution result type. This type is concurrency safe.
+type HedgePolicy[R any] interface {
+	failsafe.Policy[R]
+}
+
+// With returns a HedgePolicy for execution result type R that starts a hedge after delay has elapsed, up to 1 extra
+// hedge. For more control over hedging, including the max number of hedges, use Builder.
+func With[R any](delay time.Duration) HedgePolicy[R] {
+	return Builder[R](delay).Build()
+}
@@ -0,0 +1,76 @@
+package hedgepolicy
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+	"github.com/failsafe-go/failsafe-go/spi"
+)
+
+type hedgeExecutor[R any] struct {
+	*spi.BaseExecutor[R]
+	*hedgePolicyConfig[R]
+}
+
+func (e *hedgePolicyConfig[R]) ToExecutor(_ R) any {
+	he := &hedgeExecutor[R]{
+		BaseExecutor:      &spi.BaseExecutor[R]{},
+		hedgePolicyConfig: e,
+	}
+	he.Executor = he
+	return he
+}
+
+type hedgeAttempt[R any] struct {
+	result *spi.ExecutionResult[R]
+	exec   spi.ExecutionInternal[R]
+}
+
+// Apply races the initial execution against up to MaxHedges additional hedges, started every delay, and returns the
+// first result that isWinner accepts. Every other in-flight execution is canceled once a winner is found.
+func (e *hedgeExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *spi.ExecutionResult[R]) func(failsafe.Execution[R]) *spi.ExecutionResult[R] {
+	return func(exec failsafe.Execution[R]) *spi.ExecutionResult[R] {
+		execInternal := exec.(spi.ExecutionInternal[R])
+		results := make(chan hedgeAttempt[R], e.maxHedges+1)
+		attempts := make([]spi.ExecutionInternal[R], 0, e.maxHedges+1)
+
+		launch := func(attemptExec spi.ExecutionInternal[R]) {
+			attempts = append(attempts, attemptExec)
+			go func() {
+				results <- hedgeAttempt[R]{result: innerFn(attemptExec), exec: attemptExec}
+			}()
+		}
+
+		launch(execInternal)
+
+		hedgesStarted := 0
+		completed := 0
+		timer := time.NewTimer(e.delay)
+		defer timer.Stop()
+
+		for {
+			select {
+			case attempt := <-results:
+				completed++
+				allDone := completed == len(attempts) && hedgesStarted == e.maxHedges
+				if e.isWinner(attempt.result.Result, attempt.result.Err) || allDone {
+					for _, a := range attempts {
+						if a != attempt.exec {
+							a.Cancel()
+						}
+					}
+					return attempt.result
+				}
+			case <-timer.C:
+				if hedgesStarted < e.maxHedges {
+					hedgesStarted++
+					launch(execInternal.CopyForCancellable())
+					timer.Reset(e.delay)
+				}
+			}
+		}
+	}
+}
+
+var _ policy.Executor[any] = &hedgeExecutor[any]{}
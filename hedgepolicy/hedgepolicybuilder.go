@@ -0,0 +1,97 @@
+package hedgepolicy
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+// HedgePolicyBuilder builds HedgePolicy instances.
+//
+// This type is not concurrency safe.
+type HedgePolicyBuilder[R any] interface {
+	// WithMaxHedges configures the max number of hedges to perform, in addition to the initial execution. The
+	// default is 1.
+	WithMaxHedges(maxHedges int) HedgePolicyBuilder[R]
+
+	// WithHedgeOn configures a function that determines, given a hedge's result and error, whether that hedge should
+	// be treated as a normal result rather than a winning one, allowing the other in-flight executions to keep
+	// racing. This is useful for ignoring a hedge that completed quickly with an error. The default policy accepts
+	// any result with a nil error as a winner.
+	WithHedgeOn(fn func(result R, err error) bool) HedgePolicyBuilder[R]
+
+	// CancelOnResult narrows which nil-error results are treated as a winner to only those matching fn, replacing
+	// the default behavior of treating every nil-error result as a winner. Other in-flight executions are canceled
+	// once a matching result wins the race.
+	CancelOnResult(fn func(result R) bool) HedgePolicyBuilder[R]
+
+	// CancelOnError configures the policy to also treat an error as a winner when it matches fn, in addition to the
+	// default behavior of only ever treating a nil-error result as a winner. Other in-flight executions are canceled
+	// once a matching error wins the race.
+	CancelOnError(fn func(err error) bool) HedgePolicyBuilder[R]
+
+	// Build returns a new HedgePolicy using the builder's configuration.
+	Build() HedgePolicy[R]
+}
+
+type hedgePolicyConfig[R any] struct {
+	delay          time.Duration
+	maxHedges      int
+	hedgeOn        func(result R, err error) bool
+	cancelOnResult func(result R) bool
+	cancelOnError  func(err error) bool
+}
+
+var _ HedgePolicyBuilder[any] = &hedgePolicyConfig[any]{}
+
+// Builder returns a HedgePolicyBuilder for execution result type R that starts a hedge whenever an execution hasn't
+// completed within delay.
+func Builder[R any](delay time.Duration) HedgePolicyBuilder[R] {
+	return &hedgePolicyConfig[R]{
+		delay:     delay,
+		maxHedges: 1,
+	}
+}
+
+func (c *hedgePolicyConfig[R]) WithMaxHedges(maxHedges int) HedgePolicyBuilder[R] {
+	c.maxHedges = maxHedges
+	return c
+}
+
+func (c *hedgePolicyConfig[R]) WithHedgeOn(fn func(result R, err error) bool) HedgePolicyBuilder[R] {
+	c.hedgeOn = fn
+	return c
+}
+
+func (c *hedgePolicyConfig[R]) CancelOnResult(fn func(result R) bool) HedgePolicyBuilder[R] {
+	c.cancelOnResult = fn
+	return c
+}
+
+func (c *hedgePolicyConfig[R]) CancelOnError(fn func(err error) bool) HedgePolicyBuilder[R] {
+	c.cancelOnError = fn
+	return c
+}
+
+func (c *hedgePolicyConfig[R]) Build() HedgePolicy[R] {
+	return &hedgeExecutor[R]{hedgePolicyConfig: c}
+}
+
+// isWinner returns whether the given result/error from a hedge should win the race and cancel its siblings.
+func (c *hedgePolicyConfig[R]) isWinner(result R, err error) bool {
+	if c.hedgeOn != nil {
+		return !c.hedgeOn(result, err)
+	}
+	if err == nil {
+		if c.cancelOnResult != nil {
+			return c.cancelOnResult(result)
+		}
+		return true
+	}
+	if c.cancelOnError != nil {
+		return c.cancelOnError(err)
+	}
+	return false
+}
+
+var _ failsafe.Policy[any] = &hedgeExecutor[any]{}
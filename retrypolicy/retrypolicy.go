@@ -0,0 +1,20 @@
+// Package retrypolicy provides a failsafe.Policy that retries failed executions a configurable number of times,
+// with an optional delay between attempts.
+package retrypolicy
+
+import (
+	"github.com/failsafe-go/failsafe-go"
+)
+
+// RetryPolicy is a policy that retries failed executions a certain number of times, with a delay between attempts.
+//
+// R is the execution result type. This type is concurrency safe.
+type RetryPolicy[R any] interface {
+	failsafe.Policy[R]
+}
+
+// WithDefaults returns a RetryPolicy for execution result type R that retries up to 2 times (3 attempts in all), with
+// no delay between attempts.
+func WithDefaults[R any]() RetryPolicy[R] {
+	return Builder[R]().Build()
+}
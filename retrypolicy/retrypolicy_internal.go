@@ -0,0 +1,81 @@
+package retrypolicy
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+	"github.com/failsafe-go/failsafe-go/spi"
+)
+
+func applyJitter(delay time.Duration, jitter time.Duration) time.Duration {
+	offset := time.Duration(rand.Int63n(int64(jitter)*2+1)) - jitter
+	if delay+offset < 0 {
+		return 0
+	}
+	return delay + offset
+}
+
+func applyJitterFactor(delay time.Duration, jitterFactor float64) time.Duration {
+	offset := (rand.Float64()*2 - 1) * jitterFactor * float64(delay)
+	result := time.Duration(float64(delay) + offset)
+	if result < 0 {
+		return 0
+	}
+	return result
+}
+
+type retryPolicyExecutor[R any] struct {
+	*spi.BaseExecutor[R]
+	*retryPolicyConfig[R]
+}
+
+func (c *retryPolicyConfig[R]) ToExecutor(_ R) any {
+	rpe := &retryPolicyExecutor[R]{
+		BaseExecutor:      &spi.BaseExecutor[R]{},
+		retryPolicyConfig: c,
+	}
+	rpe.Executor = rpe
+	return rpe
+}
+
+// Apply retries the inner execution, using nextDelay to determine the wait between attempts, until a non-failure
+// result is produced, retries are exhausted, or the execution is canceled.
+func (e *retryPolicyExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *spi.ExecutionResult[R]) func(failsafe.Execution[R]) *spi.ExecutionResult[R] {
+	return func(exec failsafe.Execution[R]) *spi.ExecutionResult[R] {
+		for attempt := 0; ; attempt++ {
+			result := innerFn(exec)
+			if !e.IsFailure(result.Result, result.Err) {
+				return result
+			}
+			if exec.IsCanceled() {
+				return result
+			}
+
+			retriesExceeded := e.maxRetries >= 0 && attempt >= e.maxRetries
+			attemptsExceeded := e.maxAttempts >= 0 && exec.Attempts() >= e.maxAttempts
+			if retriesExceeded || attemptsExceeded {
+				if e.onRetriesExceeded != nil {
+					e.onRetriesExceeded(&spi.ExecutionDoneEvent[R]{ExecutionResult: result})
+				}
+				return result
+			}
+
+			if e.onRetry != nil {
+				e.onRetry(&spi.ExecutionDoneEvent[R]{ExecutionResult: result})
+			}
+
+			delay := e.nextDelay(attempt, result.Result, result.Err)
+			if delay > 0 {
+				select {
+				case <-time.After(delay):
+				case <-exec.Context().Done():
+					return result
+				}
+			}
+		}
+	}
+}
+
+var _ policy.Executor[any] = &retryPolicyExecutor[any]{}
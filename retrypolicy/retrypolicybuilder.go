@@ -0,0 +1,183 @@
+package retrypolicy
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// RetryPolicyBuilder builds RetryPolicy instances.
+//
+// This type is not concurrency safe.
+type RetryPolicyBuilder[R any] interface {
+	policy.FailurePolicyBuilder[RetryPolicyBuilder[R], R]
+	policy.DelayablePolicyBuilder[RetryPolicyBuilder[R], R]
+
+	// WithMaxRetries configures the max number of retries to perform, in addition to the initial attempt. A value of
+	// -1 indicates no limit. The default is 2.
+	WithMaxRetries(maxRetries int) RetryPolicyBuilder[R]
+
+	// WithMaxAttempts configures the max number of execution attempts, including the initial attempt. A value of -1
+	// indicates no limit.
+	WithMaxAttempts(maxAttempts int) RetryPolicyBuilder[R]
+
+	// WithBackoff configures exponentially backing off delays, starting at delay, doubling on each retry, up to
+	// maxDelay.
+	WithBackoff(delay time.Duration, maxDelay time.Duration) RetryPolicyBuilder[R]
+
+	// WithBackoffFactor configures exponentially backing off delays, starting at delay, multiplying by factor on
+	// each retry, up to maxDelay.
+	WithBackoffFactor(delay time.Duration, maxDelay time.Duration, factor float64) RetryPolicyBuilder[R]
+
+	// WithJitter configures a random jitter of up to +/- jitter to be added to each delay.
+	WithJitter(jitter time.Duration) RetryPolicyBuilder[R]
+
+	// WithJitterFactor configures a random jitter factor of up to +/- jitterFactor to be multiplied against each
+	// delay.
+	WithJitterFactor(jitterFactor float64) RetryPolicyBuilder[R]
+
+	// WithRetryAfterFn configures a fn that's called with the last result and error for an attempt, and which can
+	// return a server-signalled delay to wait before the next attempt, such as one parsed from an HTTP 429/503
+	// Retry-After header, a gRPC RetryInfo, or a custom error implementing a RetryAfter() time.Duration method. When
+	// fn returns true, its duration is used for the next retry delay instead of the configured backoff and jitter,
+	// still capped by WithMaxDelay if one is configured. When fn returns false, the configured backoff is used.
+	WithRetryAfterFn(fn func(lastResult R, lastErr error) (time.Duration, bool)) RetryPolicyBuilder[R]
+
+	// OnRetry registers the listener to be called when a new retry attempt is about to be made.
+	OnRetry(listener func(event failsafe.ExecutionEvent[R])) RetryPolicyBuilder[R]
+
+	// OnRetriesExceeded registers the listener to be called when retries are exceeded.
+	OnRetriesExceeded(listener func(event failsafe.ExecutionEvent[R])) RetryPolicyBuilder[R]
+
+	// OnAbort registers the listener to be called when an execution is aborted.
+	OnAbort(listener func(event failsafe.ExecutionEvent[R])) RetryPolicyBuilder[R]
+
+	// Build returns a new RetryPolicy using the builder's configuration.
+	Build() RetryPolicy[R]
+}
+
+type retryPolicyConfig[R any] struct {
+	*policy.BaseFailurePolicy[R]
+	delay             time.Duration
+	maxDelay          time.Duration
+	delayFactor       float64
+	jitter            time.Duration
+	jitterFactor      float64
+	maxRetries        int
+	maxAttempts       int
+	retryAfterFn      func(lastResult R, lastErr error) (time.Duration, bool)
+	onRetry           func(event failsafe.ExecutionEvent[R])
+	onRetriesExceeded func(event failsafe.ExecutionEvent[R])
+	onAbort           func(event failsafe.ExecutionEvent[R])
+}
+
+var _ RetryPolicyBuilder[any] = &retryPolicyConfig[any]{}
+
+// Builder returns a RetryPolicyBuilder for execution result type R.
+func Builder[R any]() RetryPolicyBuilder[R] {
+	return &retryPolicyConfig[R]{
+		BaseFailurePolicy: &policy.BaseFailurePolicy[R]{},
+		maxRetries:        2,
+		maxAttempts:       -1,
+	}
+}
+
+func (c *retryPolicyConfig[R]) WithMaxRetries(maxRetries int) RetryPolicyBuilder[R] {
+	c.maxRetries = maxRetries
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithMaxAttempts(maxAttempts int) RetryPolicyBuilder[R] {
+	c.maxAttempts = maxAttempts
+	c.maxRetries = maxAttempts - 1
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithDelay(delay time.Duration) RetryPolicyBuilder[R] {
+	c.delay = delay
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithMaxDelay(maxDelay time.Duration) RetryPolicyBuilder[R] {
+	c.maxDelay = maxDelay
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithBackoff(delay time.Duration, maxDelay time.Duration) RetryPolicyBuilder[R] {
+	return c.WithBackoffFactor(delay, maxDelay, 2)
+}
+
+func (c *retryPolicyConfig[R]) WithBackoffFactor(delay time.Duration, maxDelay time.Duration, factor float64) RetryPolicyBuilder[R] {
+	c.delay = delay
+	c.maxDelay = maxDelay
+	c.delayFactor = factor
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithJitter(jitter time.Duration) RetryPolicyBuilder[R] {
+	c.jitter = jitter
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithJitterFactor(jitterFactor float64) RetryPolicyBuilder[R] {
+	c.jitterFactor = jitterFactor
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithRetryAfterFn(fn func(lastResult R, lastErr error) (time.Duration, bool)) RetryPolicyBuilder[R] {
+	c.retryAfterFn = fn
+	return c
+}
+
+func (c *retryPolicyConfig[R]) OnRetry(listener func(event failsafe.ExecutionEvent[R])) RetryPolicyBuilder[R] {
+	c.onRetry = listener
+	return c
+}
+
+func (c *retryPolicyConfig[R]) OnRetriesExceeded(listener func(event failsafe.ExecutionEvent[R])) RetryPolicyBuilder[R] {
+	c.onRetriesExceeded = listener
+	return c
+}
+
+func (c *retryPolicyConfig[R]) OnAbort(listener func(event failsafe.ExecutionEvent[R])) RetryPolicyBuilder[R] {
+	c.onAbort = listener
+	return c
+}
+
+func (c *retryPolicyConfig[R]) Build() RetryPolicy[R] {
+	return &retryPolicyExecutor[R]{retryPolicyConfig: c}
+}
+
+// nextDelay returns the delay to wait before the next attempt, preferring a server-provided RetryAfter hint over the
+// configured backoff and jitter, and always capped by maxDelay when one is configured.
+func (c *retryPolicyConfig[R]) nextDelay(attempt int, lastResult R, lastErr error) time.Duration {
+	if c.retryAfterFn != nil {
+		if d, ok := c.retryAfterFn(lastResult, lastErr); ok {
+			if c.maxDelay > 0 && d > c.maxDelay {
+				return c.maxDelay
+			}
+			return d
+		}
+	}
+
+	delay := c.delay
+	if c.delayFactor > 1 {
+		for i := 0; i < attempt; i++ {
+			delay = time.Duration(float64(delay) * c.delayFactor)
+			if c.maxDelay > 0 && delay > c.maxDelay {
+				delay = c.maxDelay
+				break
+			}
+		}
+	}
+	if c.jitterFactor > 0 {
+		delay = applyJitterFactor(delay, c.jitterFactor)
+	} else if c.jitter > 0 {
+		delay = applyJitter(delay, c.jitter)
+	}
+	if c.maxDelay > 0 && delay > c.maxDelay {
+		delay = c.maxDelay
+	}
+	return delay
+}
@@ -0,0 +1,117 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/adaptivelimiter"
+	"github.com/failsafe-go/failsafe-go/fallback"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+)
+
+// RetryPolicy -> AdaptiveLimiter
+//
+// Tests that repeated failed executions shrink the limit down towards, but never below, WithMinLimit.
+func TestRetryPolicyAdaptiveLimiter(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[any]().WithMaxAttempts(10).Build()
+	al := adaptivelimiter.Builder[any]().WithInitialLimit(10).WithMinLimit(2).Build()
+
+	// When / Then
+	testutil.TestRunFailure(t, failsafe.NewExecutor[any](rp, al),
+		func(execution failsafe.Execution[any]) error {
+			time.Sleep(5 * time.Millisecond)
+			return testutil.ErrInvalidState
+		}, 10, 10, testutil.ErrInvalidState)
+	assert.GreaterOrEqual(t, al.Limit(), 2)
+	assert.Equal(t, 0, al.Inflight())
+}
+
+// AdaptiveLimiter
+//
+// Tests that the limit grows via additive increase across repeated fast, successful executions, up to WithMaxLimit.
+func TestAdaptiveLimiterGrowsOnFastSuccess(t *testing.T) {
+	// Given
+	al := adaptivelimiter.Builder[any]().WithInitialLimit(2).WithMaxLimit(5).Build()
+	executor := failsafe.NewExecutor[any](al)
+
+	// When
+	for i := 0; i < 20; i++ {
+		_, err := executor.Get(func(exec failsafe.Execution[any]) (any, error) {
+			return "ok", nil
+		})
+		assert.NoError(t, err)
+	}
+
+	// Then
+	assert.Equal(t, 5, al.Limit())
+}
+
+// AdaptiveLimiter
+//
+// Tests that an execution arriving while the limit's in-flight capacity is fully occupied fails fast with
+// ErrExceeded, rather than queueing or blocking.
+func TestAdaptiveLimiterExceeded(t *testing.T) {
+	// Given
+	al := adaptivelimiter.Builder[any]().WithInitialLimit(1).Build()
+	executor := failsafe.NewExecutor[any](al)
+	acquired := make(chan struct{})
+	release := make(chan struct{})
+
+	go func() {
+		_, _ = executor.Get(func(exec failsafe.Execution[any]) (any, error) {
+			close(acquired)
+			<-release
+			return "done", nil
+		})
+	}()
+	<-acquired
+
+	// When
+	_, err := executor.Get(func(exec failsafe.Execution[any]) (any, error) {
+		return "unused", nil
+	})
+
+	// Then
+	assert.ErrorIs(t, err, adaptivelimiter.ErrExceeded)
+	assert.Equal(t, 1, al.Limit())
+	close(release)
+}
+
+// Fallback -> AdaptiveLimiter
+//
+// Tests that a Fallback recovers from a genuine AdaptiveLimiter rejection once its in-flight capacity is exhausted.
+func TestFallbackAdaptiveLimiterExceeded(t *testing.T) {
+	// Given
+	al := adaptivelimiter.Builder[bool]().WithInitialLimit(1).Build()
+	fb := fallback.WithFn(func(exec failsafe.Execution[bool]) (bool, error) {
+		assert.ErrorIs(t, exec.LastError(), adaptivelimiter.ErrExceeded)
+		return true, nil
+	})
+	executor := failsafe.NewExecutor[bool](fb, al)
+	acquired := make(chan struct{})
+	release := make(chan struct{})
+
+	go func() {
+		_, _ = executor.Get(func(exec failsafe.Execution[bool]) (bool, error) {
+			close(acquired)
+			<-release
+			return false, nil
+		})
+	}()
+	<-acquired
+
+	// When
+	result, err := executor.Get(func(exec failsafe.Execution[bool]) (bool, error) {
+		return false, nil
+	})
+	close(release)
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, result)
+}
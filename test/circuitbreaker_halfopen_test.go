@@ -0,0 +1,97 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/circuitbreaker"
+)
+
+// CircuitBreaker
+//
+// Tests that with a half-open retry probability of 0, an open breaker never admits a request once its delay has
+// elapsed.
+func TestCircuitBreakerHalfOpenProbabilityZero(t *testing.T) {
+	// Given
+	cb := circuitbreaker.Builder[any]().
+		WithFailureThreshold(1).
+		WithDelay(10 * time.Millisecond).
+		WithHalfOpenRetryProbability(0).
+		Build()
+	cb.Open()
+	time.Sleep(20 * time.Millisecond)
+
+	// When / Then
+	executor := failsafe.NewExecutor[any](cb)
+	for i := 0; i < 20; i++ {
+		_, err := executor.Get(func(exec failsafe.Execution[any]) (any, error) {
+			return "success", nil
+		})
+		assert.ErrorIs(t, err, circuitbreaker.ErrCircuitBreakerOpen)
+	}
+	assert.True(t, cb.IsOpen())
+}
+
+// CircuitBreaker
+//
+// Tests that with a half-open retry probability of 1, the breaker admits every request once its delay has elapsed,
+// matching the traditional half-open behavior.
+func TestCircuitBreakerHalfOpenProbabilityOne(t *testing.T) {
+	// Given
+	cb := circuitbreaker.Builder[any]().
+		WithFailureThreshold(1).
+		WithSuccessThreshold(1).
+		WithDelay(10 * time.Millisecond).
+		WithHalfOpenRetryProbability(1).
+		Build()
+	cb.Open()
+	time.Sleep(20 * time.Millisecond)
+
+	// When
+	executor := failsafe.NewExecutor[any](cb)
+	result, err := executor.Get(func(exec failsafe.Execution[any]) (any, error) {
+		return "success", nil
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "success", result)
+	assert.True(t, cb.IsClosed())
+}
+
+// CircuitBreaker
+//
+// Tests that with a half-open retry probability of 0.5, roughly half of a large batch of requests are rejected while
+// the breaker is in the half-open window.
+func TestCircuitBreakerHalfOpenProbabilityHalf(t *testing.T) {
+	// Given
+	cb := circuitbreaker.Builder[any]().
+		WithFailureThreshold(1).
+		WithSuccessThreshold(1_000_000).
+		WithDelay(10 * time.Millisecond).
+		WithHalfOpenRetryProbability(0.5).
+		Build()
+	cb.Open()
+	time.Sleep(20 * time.Millisecond)
+
+	// When
+	executor := failsafe.NewExecutor[any](cb)
+	var rejected, admitted int
+	for i := 0; i < 2000; i++ {
+		_, err := executor.Get(func(exec failsafe.Execution[any]) (any, error) {
+			return "success", nil
+		})
+		if err == circuitbreaker.ErrCircuitBreakerOpen {
+			rejected++
+		} else {
+			admitted++
+		}
+	}
+
+	// Then
+	assert.InDelta(t, 1000, rejected, 150)
+	assert.InDelta(t, 1000, admitted, 150)
+}
@@ -0,0 +1,80 @@
+package test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/bulkhead"
+	"github.com/failsafe-go/failsafe-go/hedgepolicy"
+	"github.com/failsafe-go/failsafe-go/internal/policytesting"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
+)
+
+// Hedge -> Timeout
+//
+// Tests that a hedge is started after the delay elapses, and that the loser is canceled once the winner returns.
+func TestHedgeTimeout(t *testing.T) {
+	// Given
+	var attempts int32
+	hp := hedgepolicy.Builder[string](50 * time.Millisecond).WithMaxHedges(1).Build()
+	to := timeout.With[string](time.Second)
+
+	// When / Then
+	testutil.TestGetSuccess(t, failsafe.NewExecutor[string](hp, to),
+		func(exec failsafe.Execution[string]) (string, error) {
+			attempt := atomic.AddInt32(&attempts, 1)
+			if attempt == 1 {
+				testutil.WaitAndAssertCanceled(t, 200*time.Millisecond, exec)
+				return "", nil
+			}
+			return "hedge result", nil
+		},
+		-1, -1, "hedge result")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+}
+
+// RetryPolicy -> Hedge
+//
+// Tests that a hedged execution that ultimately fails is still retried as a whole by the outer RetryPolicy.
+func TestRetryPolicyHedge(t *testing.T) {
+	// Given
+	rpStats := &policytesting.Stats{}
+	rp := policytesting.WithRetryStats(retrypolicy.Builder[string](), rpStats).WithMaxAttempts(2).Build()
+	hp := hedgepolicy.Builder[string](10 * time.Millisecond).WithMaxHedges(1).Build()
+
+	// When / Then
+	testutil.TestGetFailure(t, failsafe.NewExecutor[string](rp, hp),
+		func(exec failsafe.Execution[string]) (string, error) {
+			time.Sleep(50 * time.Millisecond)
+			return "", testutil.ErrInvalidState
+		},
+		2, 2, testutil.ErrInvalidState)
+	assert.Equal(t, 2, rpStats.ExecutionCount)
+}
+
+// Hedge -> Bulkhead
+//
+// Tests that a hedge that's canceled before acquiring work still releases its Bulkhead permit.
+func TestHedgeBulkhead(t *testing.T) {
+	// Given
+	hp := hedgepolicy.Builder[string](10 * time.Millisecond).WithMaxHedges(2).Build()
+	bh := bulkhead.With[string](2)
+
+	// When / Then
+	testutil.TestGetSuccess(t, failsafe.NewExecutor[string](hp, bh),
+		func(exec failsafe.Execution[string]) (string, error) {
+			if exec.Attempts() == 1 {
+				time.Sleep(100 * time.Millisecond)
+				return "", nil
+			}
+			return "winner", nil
+		},
+		-1, -1, "winner")
+	assert.Equal(t, 2, bh.Metrics().Remaining())
+}
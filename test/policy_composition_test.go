@@ -229,6 +229,63 @@ func TestFallbackTimeout(t *testing.T) {
 		1, 1, true)
 }
 
+// FallbackChain -> RetryPolicy -> CircuitBreaker
+//
+// Tests a chain of fallback providers where the first two fail and the third succeeds.
+func TestFallbackChainRetryPolicyCircuitBreaker(t *testing.T) {
+	// Given
+	var attempts []string
+	rp := retrypolicy.WithDefaults[string]()
+	cb := circuitbreaker.Builder[string]().WithFailureThreshold(5).Build()
+	fb := fallback.WithChain(
+		func(exec failsafe.Execution[string]) (string, error) {
+			attempts = append(attempts, "primary")
+			return "", errors.New("primary down")
+		},
+		func(exec failsafe.Execution[string]) (string, error) {
+			attempts = append(attempts, "secondary")
+			return "", errors.New("secondary down")
+		},
+		func(exec failsafe.Execution[string]) (string, error) {
+			attempts = append(attempts, "tertiary")
+			assert.False(t, exec.IsCanceled())
+			return "tertiary result", nil
+		})
+
+	// When / Then
+	testutil.TestGetSuccess(t, failsafe.NewExecutor[string](fb, rp, cb),
+		testutil.GetWithExecutionFn[string]("", testutil.ErrInvalidState),
+		3, 3, "tertiary result")
+	assert.Equal(t, []string{"primary", "secondary", "tertiary"}, attempts)
+}
+
+// FallbackChain -> RetryPolicy
+//
+// Tests a chain of fallback providers that all fail, asserting the final error joins every provider's error.
+func TestFallbackChainExhausted(t *testing.T) {
+	// Given
+	errPrimary := errors.New("primary down")
+	errSecondary := errors.New("secondary down")
+	rp := retrypolicy.WithDefaults[string]()
+	fb := fallback.WithChain(
+		func(exec failsafe.Execution[string]) (string, error) {
+			return "", errPrimary
+		},
+		func(exec failsafe.Execution[string]) (string, error) {
+			return "", errSecondary
+		})
+
+	// When / Then
+	testutil.TestGetFailure(t, failsafe.NewExecutor[string](fb, rp),
+		testutil.GetWithExecutionFn[string]("", testutil.ErrInvalidState),
+		3, 3, errSecondary)
+	var chainErr *fallback.ChainError
+	result, err := failsafe.NewExecutor[string](fb, rp).Get(testutil.GetWithExecutionFn[string]("", testutil.ErrInvalidState))
+	assert.Empty(t, result)
+	assert.True(t, errors.As(err, &chainErr))
+	assert.Equal(t, []error{errPrimary, errSecondary}, chainErr.Errors())
+}
+
 // RetryPolicy -> Bulkhead
 func TestRetryPolicyBulkhead(t *testing.T) {
 	rp := retrypolicy.Builder[any]().WithMaxAttempts(7).Build()
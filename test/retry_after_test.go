@@ -0,0 +1,79 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+)
+
+// retryAfterError simulates a server response, such as an HTTP 429, that signals how long the client should wait
+// before retrying.
+type retryAfterError struct {
+	delay time.Duration
+}
+
+func (e *retryAfterError) Error() string {
+	return "too many requests"
+}
+
+func (e *retryAfterError) RetryAfter() time.Duration {
+	return e.delay
+}
+
+// RetryPolicy
+//
+// Tests that a server-provided RetryAfter hint of 300ms dominates a much shorter configured backoff.
+func TestRetryPolicyRetryAfterHintDominatesBackoff(t *testing.T) {
+	// Given
+	hint := 300 * time.Millisecond
+	rp := retrypolicy.Builder[any]().
+		WithMaxRetries(1).
+		WithBackoff(10*time.Millisecond, time.Second).
+		WithRetryAfterFn(func(lastResult any, lastErr error) (time.Duration, bool) {
+			if rae, ok := lastErr.(*retryAfterError); ok {
+				return rae.RetryAfter(), true
+			}
+			return 0, false
+		}).
+		Build()
+
+	// When
+	start := time.Now()
+	testutil.TestGetSuccess(t, failsafe.NewExecutor[any](rp),
+		testutil.ErrorNTimesThenReturn[any](&retryAfterError{delay: hint}, 1, "success"),
+		2, 2, "success")
+	elapsed := time.Since(start)
+
+	// Then
+	assert.GreaterOrEqual(t, elapsed, hint)
+	assert.Less(t, elapsed, hint+200*time.Millisecond)
+}
+
+// RetryPolicy
+//
+// Tests that the configured backoff is used as-is when WithRetryAfterFn declines to provide a hint.
+func TestRetryPolicyRetryAfterFnDeclined(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[any]().
+		WithMaxRetries(1).
+		WithBackoff(10*time.Millisecond, time.Second).
+		WithRetryAfterFn(func(lastResult any, lastErr error) (time.Duration, bool) {
+			return time.Second, false
+		}).
+		Build()
+
+	// When
+	start := time.Now()
+	testutil.TestGetSuccess(t, failsafe.NewExecutor[any](rp),
+		testutil.ErrorNTimesThenReturn[any](testutil.ErrInvalidState, 1, "success"),
+		2, 2, "success")
+	elapsed := time.Since(start)
+
+	// Then
+	assert.Less(t, elapsed, 500*time.Millisecond)
+}